@@ -1,49 +1,140 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/common-nighthawk/go-figure"
 	"github.com/gin-contrib/cors"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-contrib/sessions/redis"
+	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
+
+	"inkwell-backend-V2.0/internal/bus"
+	"inkwell-backend-V2.0/internal/command"
 	"inkwell-backend-V2.0/internal/config"
 	"inkwell-backend-V2.0/internal/db"
+	graphqlapi "inkwell-backend-V2.0/internal/graphql"
+	"inkwell-backend-V2.0/internal/graphql/generated"
+	"inkwell-backend-V2.0/internal/httpx"
+	"inkwell-backend-V2.0/internal/middleware"
 	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/oauth"
+	"inkwell-backend-V2.0/internal/query"
+	"inkwell-backend-V2.0/internal/realtime"
 	"inkwell-backend-V2.0/internal/repository"
 	"inkwell-backend-V2.0/internal/service"
+	"inkwell-backend-V2.0/internal/web"
 )
 
+// sessionCookieName is the name of the gin-contrib/sessions cookie/Redis key,
+// shared between the session middleware and the OAuth2 authorize handler.
+const sessionCookieName = "inkwell_session"
+
+// apiVersion is reported by /version alongside the startup banner.
+const apiVersion = "2.0.0-StoryScape"
+
+// debugRequestLines is how many access log lines the in-memory ring buffer
+// backing /debug/requests retains.
+const debugRequestLines = 1000
+
 func main() {
 	printStartUpBanner()
 
+	requestLog := httpx.NewRingBuffer(debugRequestLines)
+	logger := httpx.NewLogger(requestLog)
+
+	staticDir := flag.String("static-dir", "", "serve the SPA from this directory instead of the embedded build")
+	flag.Parse()
+
 	// Load XML configuration from file.
 	cfg, err := config.LoadConfig("config.xml")
 	if err != nil {
-		log.Fatalf("failed to load config: %v", err)
+		logger.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	if *staticDir != "" {
+		cfg.Context.StaticDir = *staticDir
 	}
 
 	// Initialize DB using the loaded config.
 	db.InitDBFromConfig(cfg)
 	// Run migrations.
-	db.GetDB().AutoMigrate(&model.User{}, &model.Assessment{}, &model.Story{})
+	db.GetDB().AutoMigrate(&model.User{}, &model.Assessment{}, &model.Story{}, &model.RefreshToken{}, &model.OAuthClient{})
 
 	// Create repositories.
 	userRepo := repository.NewUserRepository()
+	refreshTokenRepo := repository.NewRefreshTokenRepository()
 	assessmentRepo := repository.NewAssessmentRepository()
 	storyRepo := repository.NewStoryRepository()
+	oauthClientRepo := repository.NewOAuthClientRepository()
 
 	// Create services.
-	authService := service.NewAuthService(userRepo)
+	authService := service.NewAuthService(
+		userRepo,
+		refreshTokenRepo,
+		cfg.Auth.JWTSecret,
+		time.Duration(cfg.Auth.AccessTokenTTL)*time.Minute,
+		time.Duration(cfg.Auth.RefreshTokenTTL)*time.Minute,
+	)
 	userService := service.NewUserService(userRepo)
-	assessmentService := service.NewAssessmentService(assessmentRepo)
+
+	// The realtime hub doubles as the events.Publisher the assessment service
+	// reports grading progress through, so live sessions see answers and
+	// progress the moment they're recorded.
+	hub := realtime.NewHub(splitAndTrim(cfg.Context.AllowedOrigins))
+	assessmentService := service.NewAssessmentService(assessmentRepo, hub)
 	storyService := service.NewStoryService(storyRepo)
 
-	// Initialize Gin router.
-	r := gin.Default()
+	// Wire the CQRS buses: REST and GraphQL are both thin adapters over the
+	// same command/query handlers, so the two surfaces can't drift apart.
+	commandBus := bus.NewBus()
+	command.RegisterAssessmentHandlers(commandBus, assessmentService)
+
+	queryBus := bus.NewBus()
+	query.RegisterAssessmentHandlers(queryBus, assessmentService)
+	query.RegisterStoryHandlers(queryBus, storyService)
+	query.RegisterUserHandlers(queryBus, userService)
+
+	// Initialize Gin router. We swap in our own structured request logging
+	// instead of gin.Default()'s plain-text logger, but keep its Recovery.
+	r := gin.New()
+	r.Use(gin.Recovery(), httpx.RequestID(), httpx.AccessLog(logger))
+
+	// Serve the StoryScape SPA: a live directory when --static-dir/config.xml
+	// staticdir is set (for frontend development), the embedded build otherwise.
+	var webFS http.FileSystem
+	if cfg.Context.StaticDir != "" {
+		webFS = web.DirFS(cfg.Context.StaticDir)
+	} else {
+		webFS, err = web.FS()
+		if err != nil {
+			logger.Error("failed to load embedded SPA assets", "error", err)
+			os.Exit(1)
+		}
+	}
+	r.Use(static.Serve("/", web.NewServeFileSystem(webFS)))
+	r.NoRoute(func(c *gin.Context) {
+		c.FileFromFS("index.html", webFS)
+	})
+
+	r.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+	r.GET("/version", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"version": apiVersion})
+	})
 
 	// CORS configuration.
 	r.Use(cors.New(cors.Config{
@@ -55,15 +146,60 @@ func main() {
 		MaxAge:           12 * time.Hour,
 	}))
 
+	// Session store: cookie by default, Redis when selected from config.xml.
+	sessionStore := newSessionStore(cfg, logger)
+	r.Use(sessions.Sessions(sessionCookieName, sessionStore))
+
+	authMiddleware := middleware.AuthMiddleware(authService)
+	optionalAuth := middleware.OptionalAuth(authService)
+	requireAdmin := middleware.RequireAdmin(userRepo)
+
+	// Tail the in-memory access log ring buffer; same auth gate as the OAuth
+	// client admin routes below.
+	r.GET("/debug/requests", authMiddleware, requireAdmin, httpx.DebugRequests(requestLog))
+
+	// OAuth2 authorization server: authorization-code (+PKCE), refresh-token,
+	// and client-credentials grants for third-party integrations.
+	oauthServer := oauth.NewServer(sessionStore, sessionCookieName, oauthClientRepo)
+
+	oauthRoutes := r.Group("/oauth")
+	{
+		oauthRoutes.GET("/authorize", oauth.Authorize(oauthServer))
+		oauthRoutes.POST("/authorize", oauth.Authorize(oauthServer))
+		oauthRoutes.POST("/token", oauth.Token(oauthServer))
+		oauthRoutes.POST("/introspect", oauth.Introspect(oauthServer))
+		oauthRoutes.POST("/revoke", oauth.Revoke(oauthServer))
+
+		admin := oauthRoutes.Group("/admin/clients", authMiddleware, requireAdmin)
+		{
+			admin.POST("", oauth.RegisterClient(oauthClientRepo))
+			admin.GET("", oauth.ListClients(oauthClientRepo))
+			admin.POST("/:client_id/rotate", oauth.RotateClientSecret(oauthClientRepo))
+		}
+	}
+
 	// Auth routes.
 	auth := r.Group("/auth")
 	{
 		auth.POST("/register", func(c *gin.Context) {
-			var user model.User
-			if err := c.ShouldBindJSON(&user); err != nil {
+			var req struct {
+				Name     string `json:"name"`
+				Email    string `json:"email"`
+				AuthHash string `json:"authhash"`
+			}
+			if err := c.ShouldBindJSON(&req); err != nil {
 				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
 				return
 			}
+
+			// IsAdmin is deliberately not bindable from the request body: it
+			// must only ever be granted by an existing admin, never
+			// self-assigned by the registering caller.
+			user := model.User{
+				Name:     req.Name,
+				Email:    req.Email,
+				AuthHash: req.AuthHash,
+			}
 			if err := authService.Register(&user); err != nil {
 				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 				return
@@ -85,12 +221,61 @@ func main() {
 				c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 				return
 			}
-			c.JSON(http.StatusOK, user)
+
+			tokens, err := authService.IssueTokens(user)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			session := sessions.Default(c)
+			session.Set("user_id", user.ID)
+			if err := session.Save(); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusOK, tokens)
+		})
+
+		auth.POST("/refresh", func(c *gin.Context) {
+			var req struct {
+				Refresh string `json:"refresh"`
+			}
+			if err := c.ShouldBindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+				return
+			}
+
+			tokens, err := authService.Refresh(req.Refresh)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusOK, tokens)
+		})
+
+		auth.POST("/logout", authMiddleware, func(c *gin.Context) {
+			userID := c.MustGet("user_id").(uint)
+			if err := authService.Logout(userID); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			session := sessions.Default(c)
+			session.Clear()
+			if err := session.Save(); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
 		})
 	}
 
 	// User routes.
-	r.GET("/user", func(c *gin.Context) {
+	r.GET("/user", authMiddleware, func(c *gin.Context) {
 		users, err := userService.GetAllUsers()
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -99,13 +284,16 @@ func main() {
 		c.JSON(http.StatusOK, users)
 	})
 
-	// Assessment routes.
+	// Assessment routes. Callers authenticate with either the session cookie,
+	// a JWT access token, or an OAuth2 bearer token carrying "assessments:write".
+	// Handlers are thin adapters: they bind the request and dispatch through
+	// the command/query buses, which hold the actual business logic.
 	assessmentRoutes := r.Group("/assessments")
+	assessmentRoutes.Use(optionalAuth, oauth.RequireScope(oauthServer, oauth.ScopeAssessmentsWrite))
 	{
 		// Start an assessment
 		assessmentRoutes.POST("/start", func(c *gin.Context) {
 			var req struct {
-				UserID      uint             `json:"user_id"`
 				Title       string           `json:"title"`
 				Description string           `json:"description"`
 				Questions   []model.Question `json:"questions"`
@@ -116,7 +304,22 @@ func main() {
 				return
 			}
 
-			assessment, err := assessmentService.CreateAssessment(req.UserID, req.Title, req.Description, req.Questions)
+			// A client-credentials bearer token satisfies the scope check
+			// above but carries no user id, since it authenticates an app
+			// rather than a person; starting an assessment needs a user to
+			// attribute it to, so reject those tokens here instead of
+			// panicking on MustGet.
+			userID, ok := c.Get("user_id")
+			if !ok {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "this action requires a user-authenticated caller"})
+				return
+			}
+			assessment, err := bus.Dispatch[*model.Assessment](c.Request.Context(), commandBus, command.CreateAssessmentCommand{
+				UserID:      userID.(uint),
+				Title:       req.Title,
+				Description: req.Description,
+				Questions:   req.Questions,
+			})
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 				return
@@ -141,48 +344,23 @@ func main() {
 				return
 			}
 
-			assessment, err := assessmentService.GetAssessmentBySessionID(req.SessionID)
+			answer, err := bus.Dispatch[*model.Answer](c.Request.Context(), commandBus, command.SubmitAnswerCommand{
+				SessionID:  req.SessionID,
+				QuestionID: req.QuestionID,
+				Answer:     req.Answer,
+			})
 			if err != nil {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Session not found"})
-				return
-			}
-
-			var question model.Question
-			for _, q := range assessment.Questions {
-				if q.ID == req.QuestionID {
-					question = q
-					break
+				status := http.StatusInternalServerError
+				if errors.Is(err, command.ErrSessionNotFound) || errors.Is(err, command.ErrQuestionNotFound) {
+					status = http.StatusNotFound
 				}
-			}
-
-			if question.ID == 0 {
-				c.JSON(http.StatusNotFound, gin.H{"error": "Question not found"})
-				return
-			}
-
-			isCorrect := question.CorrectAnswer == req.Answer
-			feedback := "Incorrect"
-			if isCorrect {
-				feedback = "Correct"
-			}
-
-			answer := model.Answer{
-				AssessmentID: assessment.ID,
-				QuestionID:   req.QuestionID,
-				UserID:       assessment.UserID,
-				Answer:       req.Answer,
-				IsCorrect:    isCorrect,
-				Feedback:     feedback,
-			}
-
-			if err := assessmentService.SaveAnswer(&answer); err != nil {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				c.JSON(status, gin.H{"error": err.Error()})
 				return
 			}
 
 			c.JSON(http.StatusOK, gin.H{
-				"is_correct": isCorrect,
-				"feedback":   feedback,
+				"is_correct": answer.IsCorrect,
+				"feedback":   answer.Feedback,
 			})
 		})
 
@@ -190,7 +368,7 @@ func main() {
 		assessmentRoutes.GET("/:session_id", func(c *gin.Context) {
 			sessionID := c.Param("session_id")
 
-			assessment, err := assessmentService.GetAssessmentBySessionID(sessionID)
+			assessment, err := bus.Dispatch[*model.Assessment](c.Request.Context(), queryBus, query.FindAssessmentQuery{SessionID: sessionID})
 			if err != nil {
 				c.JSON(http.StatusNotFound, gin.H{"error": "Assessment not found"})
 				return
@@ -199,9 +377,10 @@ func main() {
 		})
 	}
 
-	// Story routes.
-	r.GET("/stories", func(c *gin.Context) {
-		stories, err := storyService.GetStories()
+	// Story routes. Callers authenticate with either the session cookie, a JWT
+	// access token, or an OAuth2 bearer token carrying "stories:read".
+	r.GET("/stories", optionalAuth, oauth.RequireScope(oauthServer, oauth.ScopeStoriesRead), func(c *gin.Context) {
+		stories, err := bus.Dispatch[[]model.Story](c.Request.Context(), queryBus, query.ListStoriesQuery{})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -209,15 +388,74 @@ func main() {
 		c.JSON(http.StatusOK, stories)
 	})
 
+	// Realtime routes: a websocket for live assessment sessions, with a
+	// long-poll fallback for clients that can't hold a persistent connection.
+	// Both resume from "last_event_id" so a reconnect doesn't lose events.
+	realtimeRoutes := r.Group("/realtime")
+	realtimeRoutes.Use(optionalAuth, oauth.RequireAuthenticated(oauthServer))
+	{
+		realtimeRoutes.GET("/ws", realtime.ServeWS(hub, assessmentRepo, userRepo))
+		realtimeRoutes.GET("/poll", realtime.Poll(hub, assessmentRepo, userRepo))
+	}
+
+	// GraphQL endpoint, backed by the same buses as the REST routes above.
+	// Query and Mutation fields mix scopes that differ per REST equivalent
+	// (stories:read, assessments:write), so rather than pick one scope for
+	// the whole route, require any authenticated caller (session, JWT, or an
+	// OAuth2 bearer token of any scope) and let each resolver apply the same
+	// per-field scope check its REST equivalent applies via RequireScope.
+	graphqlServer := gqlhandler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &graphqlapi.Resolver{CommandBus: commandBus, QueryBus: queryBus},
+	}))
+	r.POST("/graphql", optionalAuth, oauth.RequireAuthenticated(oauthServer), func(c *gin.Context) {
+		ctx := c.Request.Context()
+		if userID, ok := c.Get("user_id"); ok {
+			ctx = middleware.WithUserID(ctx, userID.(uint))
+		}
+		if scope, ok := c.Get("oauth_scope"); ok {
+			ctx = middleware.WithScope(ctx, scope.(string))
+		}
+		graphqlServer.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+	})
+
 	// Start server on the host and port specified in the XML config.
 	addr := fmt.Sprintf("%s:%d", cfg.Context.Host, cfg.Context.Port)
 	r.Run(addr)
 }
 
+// splitAndTrim splits a comma-separated config value into its non-empty,
+// whitespace-trimmed parts, such as cfg.Context.AllowedOrigins.
+func splitAndTrim(csv string) []string {
+	var out []string
+	for _, part := range strings.Split(csv, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// newSessionStore builds the gin-contrib/sessions backend selected by
+// cfg.Auth.Session.Store, defaulting to an in-memory cookie store.
+func newSessionStore(cfg *config.Config, logger *slog.Logger) sessions.Store {
+	secret := []byte(cfg.Auth.Session.Secret)
+
+	if cfg.Auth.Session.Store == "redis" {
+		store, err := redis.NewStore(10, "tcp", cfg.Auth.Session.RedisAddr, cfg.Auth.Session.RedisPassword, secret)
+		if err != nil {
+			logger.Error("failed to initialize redis session store", "error", err)
+			os.Exit(1)
+		}
+		return store
+	}
+
+	return cookie.NewStore(secret)
+}
+
 func printStartUpBanner() {
 	myFigure := figure.NewFigure("INKWELL", "", true)
 	myFigure.Print()
 
 	fmt.Println("======================================================")
-	fmt.Printf("INKWELL API (v%s)\n\n", "2.0.0-StoryScape")
+	fmt.Printf("INKWELL API (v%s)\n\n", apiVersion)
 }
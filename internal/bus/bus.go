@@ -0,0 +1,46 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Bus is a generic command/query mediator: it dispatches a message to the
+// single handler registered for its concrete type. The same implementation
+// backs both the command.Bus and query.Bus, since the dispatch mechanics are
+// identical — only the message and handler types registered on each differ.
+type Bus struct {
+	handlers map[reflect.Type]func(ctx context.Context, msg any) (any, error)
+}
+
+// NewBus builds an empty Bus.
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[reflect.Type]func(ctx context.Context, msg any) (any, error))}
+}
+
+// Register associates every message of type M with handle. Registering a
+// second handler for the same type overwrites the first.
+func Register[M any, R any](b *Bus, handle func(ctx context.Context, msg M) (R, error)) {
+	t := reflect.TypeOf(*new(M))
+	b.handlers[t] = func(ctx context.Context, msg any) (any, error) {
+		return handle(ctx, msg.(M))
+	}
+}
+
+// Dispatch routes msg to its registered handler and returns the typed result.
+func Dispatch[R any](ctx context.Context, b *Bus, msg any) (R, error) {
+	var zero R
+
+	handle, ok := b.handlers[reflect.TypeOf(msg)]
+	if !ok {
+		return zero, fmt.Errorf("bus: no handler registered for %T", msg)
+	}
+
+	result, err := handle(ctx, msg)
+	if err != nil {
+		return zero, err
+	}
+
+	return result.(R), nil
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+
+	"inkwell-backend-V2.0/internal/bus"
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/service"
+)
+
+// CreateAssessmentCommand starts a new assessment session for a user.
+type CreateAssessmentCommand struct {
+	UserID      uint
+	Title       string
+	Description string
+	Questions   []model.Question
+}
+
+// SubmitAnswerCommand grades and records a single answer within a session.
+type SubmitAnswerCommand struct {
+	SessionID  string
+	QuestionID uint
+	Answer     string
+}
+
+// RegisterAssessmentHandlers wires the assessment write-side handlers onto b.
+func RegisterAssessmentHandlers(b *bus.Bus, assessmentService *service.AssessmentService) {
+	bus.Register(b, func(ctx context.Context, cmd CreateAssessmentCommand) (*model.Assessment, error) {
+		return assessmentService.CreateAssessment(cmd.UserID, cmd.Title, cmd.Description, cmd.Questions)
+	})
+
+	bus.Register(b, func(ctx context.Context, cmd SubmitAnswerCommand) (*model.Answer, error) {
+		assessment, err := assessmentService.GetAssessmentBySessionID(cmd.SessionID)
+		if err != nil {
+			return nil, ErrSessionNotFound
+		}
+
+		var question model.Question
+		for _, q := range assessment.Questions {
+			if q.ID == cmd.QuestionID {
+				question = q
+				break
+			}
+		}
+		if question.ID == 0 {
+			return nil, ErrQuestionNotFound
+		}
+
+		isCorrect := question.CorrectAnswer == cmd.Answer
+		feedback := "Incorrect"
+		if isCorrect {
+			feedback = "Correct"
+		}
+
+		answer := &model.Answer{
+			AssessmentID: assessment.ID,
+			QuestionID:   cmd.QuestionID,
+			UserID:       assessment.UserID,
+			Answer:       cmd.Answer,
+			IsCorrect:    isCorrect,
+			Feedback:     feedback,
+		}
+
+		if err := assessmentService.SaveAnswer(assessment, answer); err != nil {
+			return nil, err
+		}
+
+		return answer, nil
+	})
+}
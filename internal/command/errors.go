@@ -0,0 +1,12 @@
+package command
+
+import "errors"
+
+var (
+	// ErrSessionNotFound is returned when a command references an assessment
+	// session id that does not exist.
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrQuestionNotFound is returned when a command references a question id
+	// that is not part of the referenced assessment.
+	ErrQuestionNotFound = errors.New("question not found")
+)
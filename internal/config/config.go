@@ -0,0 +1,64 @@
+package config
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+// Config is the root of the application's XML configuration file.
+type Config struct {
+	XMLName  xml.Name       `xml:"config"`
+	Context  ContextConfig  `xml:"context"`
+	Database DatabaseConfig `xml:"database"`
+	Auth     AuthConfig     `xml:"auth"`
+}
+
+// ContextConfig holds the settings for the HTTP server.
+type ContextConfig struct {
+	Host           string `xml:"host"`
+	Port           int    `xml:"port"`
+	StaticDir      string `xml:"staticdir"`      // overrides the embedded SPA build when set; see --static-dir
+	AllowedOrigins string `xml:"allowedorigins"` // comma-separated Origins allowed to open a /realtime/ws connection; empty means same-origin only
+}
+
+// DatabaseConfig holds the settings used to connect to the backing store.
+type DatabaseConfig struct {
+	Driver   string `xml:"driver"`
+	Host     string `xml:"host"`
+	Port     int    `xml:"port"`
+	User     string `xml:"user"`
+	Password string `xml:"password"`
+	Name     string `xml:"name"`
+}
+
+// AuthConfig holds the settings for session and token based authentication.
+type AuthConfig struct {
+	JWTSecret       string        `xml:"jwtsecret"`
+	AccessTokenTTL  int           `xml:"accesstokenttl"`  // minutes
+	RefreshTokenTTL int           `xml:"refreshtokenttl"` // minutes
+	Session         SessionConfig `xml:"session"`
+}
+
+// SessionConfig selects and configures the gin-contrib/sessions backend.
+type SessionConfig struct {
+	Store         string `xml:"store"` // "cookie" or "redis"
+	Secret        string `xml:"secret"`
+	RedisAddr     string `xml:"redisaddr"`
+	RedisPassword string `xml:"redispassword"`
+}
+
+// LoadConfig reads and parses the XML configuration file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var cfg Config
+	if err := xml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	return &cfg, nil
+}
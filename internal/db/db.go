@@ -0,0 +1,32 @@
+package db
+
+import (
+	"fmt"
+	"log"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"inkwell-backend-V2.0/internal/config"
+)
+
+var instance *gorm.DB
+
+// InitDBFromConfig opens the database connection described by cfg and
+// stores it for later retrieval via GetDB.
+func InitDBFromConfig(cfg *config.Config) {
+	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Database.Password, cfg.Database.Name)
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	instance = conn
+}
+
+// GetDB returns the process-wide database handle initialized by InitDBFromConfig.
+func GetDB() *gorm.DB {
+	return instance
+}
@@ -0,0 +1,46 @@
+// Package events defines the in-process event bus that carries assessment
+// session updates from the service layer to the realtime transport, without
+// the service layer importing the transport itself.
+package events
+
+import "fmt"
+
+// Event types published as an assessment session progresses.
+const (
+	TypeQuestionNext     = "question:next"
+	TypeAnswerGraded     = "answer:graded"
+	TypeSessionProgress  = "session:progress"
+	TypeSessionCompleted = "session:completed"
+)
+
+// Event is a single message published to a room.
+type Event struct {
+	ID      uint64 `json:"id"` // monotonically increasing; doubles as the resume cursor (last_event_id)
+	Type    string `json:"type"`
+	Room    string `json:"room"`
+	Payload any    `json:"payload"`
+}
+
+// Publisher is implemented by the realtime hub. Services depend on this
+// narrow interface so they never import the transport layer directly.
+type Publisher interface {
+	Publish(Event)
+}
+
+// SessionProgress summarizes how far along a session is.
+type SessionProgress struct {
+	SessionID string `json:"session_id"`
+	Answered  int    `json:"answered"`
+	Total     int    `json:"total"`
+	Completed bool   `json:"completed"`
+}
+
+// SessionRoom is the room a client joins to watch one assessment session.
+func SessionRoom(sessionID string) string {
+	return fmt.Sprintf("session:%s", sessionID)
+}
+
+// UserRoom is the room a teacher joins to watch one learner across sessions.
+func UserRoom(userID uint) string {
+	return fmt.Sprintf("user:%d", userID)
+}
@@ -0,0 +1,10 @@
+// Package model holds the GraphQL-only input/output types that don't map
+// onto an existing internal/model type, so gqlgen's autobinding has
+// somewhere to find them.
+package model
+
+// QuestionInput is the payload for a single question on startAssessment.
+type QuestionInput struct {
+	Text          string `json:"text"`
+	CorrectAnswer string `json:"correctAnswer"`
+}
@@ -0,0 +1,170 @@
+package graphql
+
+//go:generate go run github.com/99designs/gqlgen generate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"inkwell-backend-V2.0/internal/bus"
+	"inkwell-backend-V2.0/internal/command"
+	"inkwell-backend-V2.0/internal/graphql/generated"
+	graphqlmodel "inkwell-backend-V2.0/internal/graphql/model"
+	"inkwell-backend-V2.0/internal/middleware"
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/oauth"
+	"inkwell-backend-V2.0/internal/query"
+)
+
+// Resolver is the root GraphQL resolver. It holds no business logic of its
+// own — every field dispatches through the same command/query buses the REST
+// handlers in main.go use, so the two surfaces can never drift apart.
+type Resolver struct {
+	CommandBus *bus.Bus
+	QueryBus   *bus.Bus
+}
+
+type queryResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
+type storyResolver struct{ *Resolver }
+type questionResolver struct{ *Resolver }
+type assessmentResolver struct{ *Resolver }
+type answerResolver struct{ *Resolver }
+
+// Query returns the resolver for the schema's root Query type.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+// Mutation returns the resolver for the schema's root Mutation type.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+// User returns the resolver for model.User's ID field conversion to the
+// schema's ID scalar, since model.User.ID is a Go uint rather than a string.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
+// Story returns the resolver for model.Story's ID field conversion.
+func (r *Resolver) Story() generated.StoryResolver { return &storyResolver{r} }
+
+// Question returns the resolver for model.Question's ID field conversion.
+func (r *Resolver) Question() generated.QuestionResolver { return &questionResolver{r} }
+
+// Assessment returns the resolver for model.Assessment's ID field conversion.
+func (r *Resolver) Assessment() generated.AssessmentResolver { return &assessmentResolver{r} }
+
+// Answer returns the resolver for model.Answer's ID and QuestionID field conversions.
+func (r *Resolver) Answer() generated.AnswerResolver { return &answerResolver{r} }
+
+func (r *userResolver) ID(ctx context.Context, obj *model.User) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *storyResolver) ID(ctx context.Context, obj *model.Story) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *questionResolver) ID(ctx context.Context, obj *model.Question) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *assessmentResolver) ID(ctx context.Context, obj *model.Assessment) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *answerResolver) ID(ctx context.Context, obj *model.Answer) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *answerResolver) QuestionID(ctx context.Context, obj *model.Answer) (string, error) {
+	return strconv.FormatUint(uint64(obj.QuestionID), 10), nil
+}
+
+// requireScope mirrors oauth.RequireScope for fields whose REST equivalent is
+// gated by it. It only rejects callers authenticated via an OAuth2 bearer
+// token that lacks the scope; a session- or JWT-authenticated caller bypasses
+// the check the same way it bypasses RequireScope on the REST routes.
+func requireScope(ctx context.Context, required string) error {
+	if scope, limited := middleware.ScopeFromContext(ctx); limited && !oauth.HasScope(scope, required) {
+		return errors.New("insufficient scope")
+	}
+	return nil
+}
+
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	return bus.Dispatch[*model.User](ctx, r.QueryBus, query.FindCurrentUserQuery{UserID: userID})
+}
+
+func (r *queryResolver) Assessment(ctx context.Context, sessionID string) (*model.Assessment, error) {
+	// assessments:write, matching the scope REST requires for the whole
+	// /assessments group this query mirrors, including its GET route.
+	if err := requireScope(ctx, oauth.ScopeAssessmentsWrite); err != nil {
+		return nil, err
+	}
+	return bus.Dispatch[*model.Assessment](ctx, r.QueryBus, query.FindAssessmentQuery{SessionID: sessionID})
+}
+
+func (r *queryResolver) Stories(ctx context.Context) ([]*model.Story, error) {
+	if err := requireScope(ctx, oauth.ScopeStoriesRead); err != nil {
+		return nil, err
+	}
+
+	stories, err := bus.Dispatch[[]model.Story](ctx, r.QueryBus, query.ListStoriesQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Story, len(stories))
+	for i := range stories {
+		result[i] = &stories[i]
+	}
+	return result, nil
+}
+
+func (r *mutationResolver) StartAssessment(ctx context.Context, title string, description string, questions []*graphqlmodel.QuestionInput) (*model.Assessment, error) {
+	if err := requireScope(ctx, oauth.ScopeAssessmentsWrite); err != nil {
+		return nil, err
+	}
+
+	// A client-credentials bearer token can satisfy the scope check above
+	// but carries no user id, since it authenticates an app rather than a
+	// person; starting an assessment needs a user to attribute it to, the
+	// same way the REST POST /assessments/start handler requires one.
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.New("this action requires a user-authenticated caller")
+	}
+
+	modelQuestions := make([]model.Question, len(questions))
+	for i, q := range questions {
+		modelQuestions[i] = model.Question{Text: q.Text, CorrectAnswer: q.CorrectAnswer}
+	}
+
+	return bus.Dispatch[*model.Assessment](ctx, r.CommandBus, command.CreateAssessmentCommand{
+		UserID:      userID,
+		Title:       title,
+		Description: description,
+		Questions:   modelQuestions,
+	})
+}
+
+func (r *mutationResolver) SubmitAnswer(ctx context.Context, sessionID string, questionID string, answer string) (*model.Answer, error) {
+	if err := requireScope(ctx, oauth.ScopeAssessmentsWrite); err != nil {
+		return nil, err
+	}
+
+	parsedQuestionID, err := strconv.ParseUint(questionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid questionId: %w", err)
+	}
+
+	return bus.Dispatch[*model.Answer](ctx, r.CommandBus, command.SubmitAnswerCommand{
+		SessionID:  sessionID,
+		QuestionID: uint(parsedQuestionID),
+		Answer:     answer,
+	})
+}
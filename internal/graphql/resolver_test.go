@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"inkwell-backend-V2.0/internal/middleware"
+	"inkwell-backend-V2.0/internal/oauth"
+)
+
+func TestRequireScopeAllowsSessionAuthenticatedCaller(t *testing.T) {
+	// No oauth_scope in context means the caller authenticated via session
+	// or JWT, which bypasses per-scope checks the same way it does on the
+	// REST routes.
+	if err := requireScope(context.Background(), oauth.ScopeAssessmentsWrite); err != nil {
+		t.Errorf("requireScope rejected a caller with no oauth_scope set: %v", err)
+	}
+}
+
+func TestRequireScopeAllowsBearerTokenWithGrantedScope(t *testing.T) {
+	ctx := middleware.WithScope(context.Background(), oauth.ScopeAssessmentsWrite)
+	if err := requireScope(ctx, oauth.ScopeAssessmentsWrite); err != nil {
+		t.Errorf("requireScope rejected a bearer token granted the required scope: %v", err)
+	}
+}
+
+func TestRequireScopeRejectsBearerTokenMissingScope(t *testing.T) {
+	ctx := middleware.WithScope(context.Background(), oauth.ScopeStoriesRead)
+	if err := requireScope(ctx, oauth.ScopeAssessmentsWrite); err == nil {
+		t.Error("requireScope allowed a bearer token missing the required scope")
+	}
+}
@@ -0,0 +1,28 @@
+package httpx
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultDebugLines is how many ring buffer lines /debug/requests returns
+// when the caller doesn't specify "n".
+const defaultDebugLines = 100
+
+// DebugRequests serves the last N access log lines from ring as JSON, for
+// quick inspection without shipping logs anywhere. It's mounted behind
+// AuthMiddleware in main.go.
+func DebugRequests(ring *RingBuffer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		n := defaultDebugLines
+		if raw := c.Query("n"); raw != "" {
+			if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+				n = parsed
+			}
+		}
+
+		c.JSON(http.StatusOK, gin.H{"lines": ring.Lines(n)})
+	}
+}
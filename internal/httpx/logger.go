@@ -0,0 +1,65 @@
+package httpx
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewLogger builds the structured JSON logger used for both the startup log
+// and per-request access logs. Every line is written to stdout and mirrored
+// into ring, which backs /debug/requests.
+func NewLogger(ring *RingBuffer) *slog.Logger {
+	w := io.MultiWriter(os.Stdout, ring)
+	return slog.New(slog.NewJSONHandler(w, nil))
+}
+
+// loggerKey is the context key a request-scoped logger is stored under.
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying logger.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// LoggerFromContext returns the logger stored in ctx by WithLogger, or
+// fallback if none is set.
+func LoggerFromContext(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// AccessLog logs one structured line per request (method, path, status,
+// latency, client IP, request_id, and user_id when authenticated), and
+// injects a logger carrying those same fields into the request context so
+// downstream services can log with the same correlation fields.
+func AccessLog(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID, _ := RequestIDFromContext(c)
+		scoped := logger.With("request_id", requestID)
+		c.Request = c.Request.WithContext(WithLogger(c.Request.Context(), scoped))
+
+		c.Next()
+
+		fields := []any{
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"latency_ms", time.Since(start).Milliseconds(),
+			"client_ip", c.ClientIP(),
+		}
+		if userID, ok := c.Get("user_id"); ok {
+			fields = append(fields, "user_id", userID)
+		}
+
+		scoped.Info("http_request", fields...)
+	}
+}
@@ -0,0 +1,38 @@
+package httpx
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oklog/ulid/v2"
+)
+
+// RequestIDHeader is the response header carrying the per-request ULID, so a
+// caller can hand it back when reporting an issue.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey is the gin context key the ULID is stored under.
+const requestIDKey = "request_id"
+
+// RequestID generates a ULID for every request, stores it under
+// c.Set("request_id", ...), and echoes it back as X-Request-ID so it can be
+// correlated across logs and client-side error reports.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+		c.Set(requestIDKey, id)
+		c.Writer.Header().Set(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the ULID generated for c's request, if any.
+func RequestIDFromContext(c *gin.Context) (string, bool) {
+	id, ok := c.Get(requestIDKey)
+	if !ok {
+		return "", false
+	}
+	s, ok := id.(string)
+	return s, ok
+}
@@ -0,0 +1,53 @@
+package httpx
+
+import "sync"
+
+// RingBuffer is a fixed-capacity, thread-safe log sink: writes past capacity
+// overwrite the oldest entry. It backs the /debug/requests endpoint so a
+// request's access log line can be inspected without shipping it anywhere.
+type RingBuffer struct {
+	mu       sync.Mutex
+	lines    []string
+	capacity int
+	next     int
+	full     bool
+}
+
+// NewRingBuffer builds a RingBuffer holding at most capacity lines.
+func NewRingBuffer(capacity int) *RingBuffer {
+	return &RingBuffer{lines: make([]string, capacity), capacity: capacity}
+}
+
+// Write implements io.Writer, treating p as a single log line.
+func (b *RingBuffer) Write(p []byte) (int, error) {
+	line := string(p)
+
+	b.mu.Lock()
+	b.lines[b.next] = line
+	b.next = (b.next + 1) % b.capacity
+	if b.next == 0 {
+		b.full = true
+	}
+	b.mu.Unlock()
+
+	return len(p), nil
+}
+
+// Lines returns up to the last n lines written, oldest first.
+func (b *RingBuffer) Lines(n int) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var ordered []string
+	if b.full {
+		ordered = append(ordered, b.lines[b.next:]...)
+		ordered = append(ordered, b.lines[:b.next]...)
+	} else {
+		ordered = append(ordered, b.lines[:b.next]...)
+	}
+
+	if n > 0 && len(ordered) > n {
+		ordered = ordered[len(ordered)-n:]
+	}
+	return ordered
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+
+	"inkwell-backend-V2.0/internal/repository"
+	"inkwell-backend-V2.0/internal/service"
+)
+
+// AuthMiddleware populates "user_id" in the gin context from either the
+// session cookie or an `Authorization: Bearer <access token>` header. Requests
+// with neither are rejected with 401.
+func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
+	optional := OptionalAuth(authService)
+	return func(c *gin.Context) {
+		optional(c)
+		if c.IsAborted() {
+			return
+		}
+		if _, ok := c.Get("user_id"); !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		}
+	}
+}
+
+// OptionalAuth populates "user_id" in the gin context from either the session
+// cookie or an `Authorization: Bearer <access token>` header, when present,
+// but never aborts the request. Use it ahead of a handler or middleware (such
+// as oauth.RequireScope) that accepts other forms of authentication too.
+func OptionalAuth(authService *service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if userID, ok := sessions.Default(c).Get("user_id").(uint); ok {
+			c.Set("user_id", userID)
+			c.Next()
+			return
+		}
+
+		header := c.GetHeader("Authorization")
+		if strings.HasPrefix(header, "Bearer ") {
+			accessToken := strings.TrimPrefix(header, "Bearer ")
+			if userID, err := authService.ValidateAccess(accessToken); err == nil {
+				c.Set("user_id", userID)
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// RequireAdmin must run after AuthMiddleware (or another middleware that
+// populates "user_id"). It rejects the request with 403 unless the
+// authenticated user has model.User.IsAdmin set, for routes such as the
+// OAuth client admin endpoints and /debug/requests that must not be reachable
+// by an ordinary account.
+func RequireAdmin(userRepo repository.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := c.Get("user_id")
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			return
+		}
+
+		user, err := userRepo.FindByID(userID.(uint))
+		if err != nil || !user.IsAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import "context"
+
+type contextKey string
+
+const (
+	userIDContextKey contextKey = "user_id"
+	scopeContextKey  contextKey = "oauth_scope"
+)
+
+// WithUserID returns a copy of ctx carrying the authenticated caller's id, for
+// handlers (such as the GraphQL resolvers) that only see a context.Context
+// rather than a *gin.Context.
+func WithUserID(ctx context.Context, userID uint) context.Context {
+	return context.WithValue(ctx, userIDContextKey, userID)
+}
+
+// UserIDFromContext retrieves the id set by WithUserID, if any.
+func UserIDFromContext(ctx context.Context) (uint, bool) {
+	userID, ok := ctx.Value(userIDContextKey).(uint)
+	return userID, ok
+}
+
+// WithScope returns a copy of ctx carrying the space-delimited scope granted
+// to an OAuth2 bearer token, for handlers (such as the GraphQL resolvers)
+// that need to enforce the same per-operation scopes their REST equivalents
+// require via oauth.RequireScope. Only set when the caller authenticated via
+// a bearer token; a session- or JWT-authenticated caller has full access, the
+// same way it bypasses oauth.RequireScope on the REST routes.
+func WithScope(ctx context.Context, scope string) context.Context {
+	return context.WithValue(ctx, scopeContextKey, scope)
+}
+
+// ScopeFromContext retrieves the scope set by WithScope, if any. ok is false
+// for a session- or JWT-authenticated caller, which is not scope-restricted.
+func ScopeFromContext(ctx context.Context) (scope string, ok bool) {
+	scope, ok = ctx.Value(scopeContextKey).(string)
+	return scope, ok
+}
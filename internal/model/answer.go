@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Answer records a user's response to a single Question within an Assessment.
+type Answer struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	AssessmentID uint      `json:"assessment_id"`
+	QuestionID   uint      `json:"question_id"`
+	UserID       uint      `json:"user_id"`
+	Answer       string    `json:"answer"`
+	IsCorrect    bool      `json:"is_correct"`
+	Feedback     string    `json:"feedback"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Assessment is a single attempt at a set of questions by a user.
+type Assessment struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	SessionID   string     `gorm:"uniqueIndex" json:"session_id"`
+	UserID      uint       `json:"user_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Questions   []Question `json:"questions"`
+	Answers     []Answer   `json:"answers"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
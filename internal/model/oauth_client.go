@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// OAuthClient is a third-party application registered to access Inkwell
+// content through the OAuth2 provider.
+type OAuthClient struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	ClientID    string    `gorm:"uniqueIndex" json:"client_id"`
+	SecretHash  string    `json:"-"`
+	Name        string    `json:"name"`
+	RedirectURI string    `json:"redirect_uri"`
+	Scopes      string    `json:"scopes"` // space-delimited, e.g. "stories:read profile"
+	CreatedAt   time.Time `json:"created_at"`
+}
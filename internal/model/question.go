@@ -0,0 +1,9 @@
+package model
+
+// Question is a single question belonging to an Assessment.
+type Question struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	AssessmentID  uint   `json:"assessment_id"`
+	Text          string `json:"text"`
+	CorrectAnswer string `json:"correct_answer"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// RefreshToken is an opaque, rotating credential used to mint new access tokens
+// without requiring the user to re-authenticate.
+type RefreshToken struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"index" json:"user_id"`
+	TokenHash string    `gorm:"uniqueIndex" json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Revoked   bool      `json:"revoked"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Story is a piece of StoryScape reading content served to learners.
+type Story struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
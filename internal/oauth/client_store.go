@@ -0,0 +1,57 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-oauth2/oauth2/v4"
+
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// ClientStore adapts repository.OAuthClientRepository to oauth2.ClientStore so
+// the token server can look up registered third-party applications.
+type ClientStore struct {
+	repo repository.OAuthClientRepository
+}
+
+// NewClientStore builds a ClientStore backed by the given OAuthClientRepository.
+func NewClientStore(repo repository.OAuthClientRepository) *ClientStore {
+	return &ClientStore{repo: repo}
+}
+
+// GetByID implements oauth2.ClientStore.
+func (s *ClientStore) GetByID(ctx context.Context, id string) (oauth2.ClientInfo, error) {
+	client, err := s.repo.FindByClientID(id)
+	if err != nil {
+		return nil, errors.New("client not found")
+	}
+
+	return &hashedSecretClient{
+		id:         client.ClientID,
+		secretHash: client.SecretHash,
+		domain:     client.RedirectURI,
+	}, nil
+}
+
+// hashedSecretClient implements oauth2.ClientInfo and oauth2.ClientPasswordVerifier.
+// The manager only ever has the plaintext secret a caller submits, while we
+// only ever persist its sha256 hash, so GetSecret can't be compared directly —
+// VerifyPassword hashes the caller-supplied secret the same way RegisterClient
+// and RotateClientSecret do before comparing.
+type hashedSecretClient struct {
+	id         string
+	secretHash string
+	domain     string
+}
+
+func (c *hashedSecretClient) GetID() string     { return c.id }
+func (c *hashedSecretClient) GetSecret() string { return c.secretHash }
+func (c *hashedSecretClient) GetDomain() string { return c.domain }
+func (c *hashedSecretClient) IsPublic() bool    { return false }
+func (c *hashedSecretClient) GetUserID() string { return "" }
+
+// VerifyPassword implements oauth2.ClientPasswordVerifier.
+func (c *hashedSecretClient) VerifyPassword(secret string) bool {
+	return hashSecret(secret) == c.secretHash
+}
@@ -0,0 +1,78 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"inkwell-backend-V2.0/internal/model"
+)
+
+type fakeOAuthClientRepo struct {
+	byClientID map[string]*model.OAuthClient
+}
+
+func newFakeOAuthClientRepo() *fakeOAuthClientRepo {
+	return &fakeOAuthClientRepo{byClientID: make(map[string]*model.OAuthClient)}
+}
+
+func (r *fakeOAuthClientRepo) Create(client *model.OAuthClient) error {
+	r.byClientID[client.ClientID] = client
+	return nil
+}
+
+func (r *fakeOAuthClientRepo) FindByClientID(clientID string) (*model.OAuthClient, error) {
+	client, ok := r.byClientID[clientID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return client, nil
+}
+
+func (r *fakeOAuthClientRepo) FindAll() ([]model.OAuthClient, error) {
+	var out []model.OAuthClient
+	for _, c := range r.byClientID {
+		out = append(out, *c)
+	}
+	return out, nil
+}
+
+func (r *fakeOAuthClientRepo) UpdateSecretHash(clientID, secretHash string) error {
+	client, ok := r.byClientID[clientID]
+	if !ok {
+		return errors.New("not found")
+	}
+	client.SecretHash = secretHash
+	return nil
+}
+
+func TestClientStoreVerifyPasswordAcceptsPlaintextMatchingStoredHash(t *testing.T) {
+	repo := newFakeOAuthClientRepo()
+	repo.Create(&model.OAuthClient{ClientID: "client-1", SecretHash: hashSecret("correct-horse")})
+
+	store := NewClientStore(repo)
+	info, err := store.GetByID(context.Background(), "client-1")
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+
+	verifier, ok := info.(interface{ VerifyPassword(string) bool })
+	if !ok {
+		t.Fatalf("client info %T does not implement oauth2.ClientPasswordVerifier", info)
+	}
+
+	if !verifier.VerifyPassword("correct-horse") {
+		t.Error("VerifyPassword rejected the plaintext secret matching the stored hash")
+	}
+	if verifier.VerifyPassword("wrong-secret") {
+		t.Error("VerifyPassword accepted an incorrect secret")
+	}
+}
+
+func TestClientStoreGetByIDUnknownClient(t *testing.T) {
+	store := NewClientStore(newFakeOAuthClientRepo())
+
+	if _, err := store.GetByID(context.Background(), "does-not-exist"); err == nil {
+		t.Error("GetByID returned no error for an unregistered client id")
+	}
+}
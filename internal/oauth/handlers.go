@@ -0,0 +1,153 @@
+package oauth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-oauth2/oauth2/v4/server"
+
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// Authorize renders/handles the /oauth/authorize step of the
+// authorization-code grant (with optional PKCE parameters).
+func Authorize(srv *server.Server) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := srv.HandleAuthorizeRequest(c.Writer, c.Request); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+	}
+}
+
+// Token handles the /oauth/token endpoint for all allowed grant types.
+func Token(srv *server.Server) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := srv.HandleTokenRequest(c.Writer, c.Request); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+	}
+}
+
+// Introspect implements a minimal RFC 7662 style token introspection endpoint.
+func Introspect(srv *server.Server) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.PostForm("token")
+		if token == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "missing token"})
+			return
+		}
+
+		info, err := srv.Manager.LoadAccessToken(c.Request.Context(), token)
+		if err != nil {
+			c.JSON(http.StatusOK, gin.H{"active": false})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"active":    true,
+			"client_id": info.GetClientID(),
+			"user_id":   info.GetUserID(),
+			"scope":     info.GetScope(),
+			"exp":       info.GetAccessCreateAt().Add(info.GetAccessExpiresIn()).Unix(),
+		})
+	}
+}
+
+// Revoke implements a minimal RFC 7009 style token revocation endpoint.
+func Revoke(srv *server.Server) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.PostForm("token")
+		if token == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "missing token"})
+			return
+		}
+
+		// Best-effort: the token may be an access or a refresh token.
+		_ = srv.Manager.RemoveAccessToken(c.Request.Context(), token)
+		_ = srv.Manager.RemoveRefreshToken(c.Request.Context(), token)
+
+		c.JSON(http.StatusOK, gin.H{"message": "token revoked"})
+	}
+}
+
+// RegisterClient is the admin endpoint used to onboard a new third-party
+// application. The plaintext client secret is returned exactly once.
+func RegisterClient(clientRepo repository.OAuthClientRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Name        string `json:"name"`
+			RedirectURI string `json:"redirect_uri"`
+			Scopes      string `json:"scopes"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+			return
+		}
+
+		clientID := randomToken()
+		secret := randomToken()
+
+		client := &model.OAuthClient{
+			ClientID:    clientID,
+			SecretHash:  hashSecret(secret),
+			Name:        req.Name,
+			RedirectURI: req.RedirectURI,
+			Scopes:      req.Scopes,
+		}
+
+		if err := clientRepo.Create(client); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"client_id":     clientID,
+			"client_secret": secret,
+		})
+	}
+}
+
+// ListClients is the admin endpoint used to review registered applications.
+func ListClients(clientRepo repository.OAuthClientRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clients, err := clientRepo.FindAll()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, clients)
+	}
+}
+
+// RotateClientSecret is the admin endpoint used to invalidate a client's
+// current secret and issue a new one.
+func RotateClientSecret(clientRepo repository.OAuthClientRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientID := c.Param("client_id")
+
+		secret := randomToken()
+		if err := clientRepo.UpdateSecretHash(clientID, hashSecret(secret)); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"client_secret": secret})
+	}
+}
+
+func randomToken() string {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
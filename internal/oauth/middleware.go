@@ -0,0 +1,55 @@
+package oauth
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-oauth2/oauth2/v4/server"
+)
+
+// RequireAuthenticated allows the request through if an earlier middleware
+// already identified the caller (session cookie or JWT access token), or if
+// the request carries a valid OAuth2 bearer token of any scope.
+func RequireAuthenticated(srv *server.Server) gin.HandlerFunc {
+	return requireScope(srv, "")
+}
+
+// RequireScope allows the request through if an earlier middleware already
+// identified the caller, or if the request carries a valid OAuth2 bearer
+// token granted the given scope.
+func RequireScope(srv *server.Server, scope string) gin.HandlerFunc {
+	return requireScope(srv, scope)
+}
+
+func requireScope(srv *server.Server, scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, exists := c.Get("user_id"); exists {
+			c.Next()
+			return
+		}
+
+		tokenInfo, err := srv.ValidationBearerToken(c.Request)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			return
+		}
+
+		if scope != "" && !HasScope(tokenInfo.GetScope(), scope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient scope"})
+			return
+		}
+
+		// Record the token's granted scope so callers that multiplex several
+		// operations behind one route (the GraphQL endpoint) can apply their
+		// own per-operation scope checks, the way RequireScope does here for
+		// a single REST route.
+		c.Set("oauth_scope", tokenInfo.GetScope())
+
+		if userID, err := strconv.ParseUint(tokenInfo.GetUserID(), 10, 64); err == nil {
+			c.Set("user_id", uint(userID))
+		}
+
+		c.Next()
+	}
+}
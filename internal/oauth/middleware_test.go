@@ -0,0 +1,164 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/generates"
+	"github.com/go-oauth2/oauth2/v4/manage"
+	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/go-oauth2/oauth2/v4/store"
+
+	"inkwell-backend-V2.0/internal/model"
+)
+
+// newTestServer builds a real OAuth2 server backed by an in-memory token
+// store and a single registered client, so requireScope can be exercised
+// against an actual bearer token rather than a mock.
+func newTestServer(t *testing.T, scopes string) (*server.Server, string) {
+	t.Helper()
+
+	repo := newFakeOAuthClientRepo()
+	repo.Create(&model.OAuthClient{ClientID: "client-1", SecretHash: hashSecret("secret-1"), Scopes: scopes})
+
+	manager := manage.NewDefaultManager()
+	manager.MustTokenStorage(store.NewMemoryTokenStore())
+	manager.MapAccessGenerate(generates.NewAccessGenerate())
+	manager.MapClientStorage(NewClientStore(repo))
+
+	cfg := &server.Config{
+		TokenType:            "Bearer",
+		AllowedGrantTypes:    []oauth2.GrantType{oauth2.ClientCredentials},
+		AllowedResponseTypes: []oauth2.ResponseType{oauth2.Code},
+	}
+	srv := server.NewServer(cfg, manager)
+
+	token, err := srv.GetAccessToken(context.Background(), oauth2.ClientCredentials, &oauth2.TokenGenerateRequest{
+		ClientID:     "client-1",
+		ClientSecret: "secret-1",
+		Scope:        scopes,
+	})
+	if err != nil {
+		t.Fatalf("GetAccessToken: %v", err)
+	}
+
+	return srv, token.GetAccess()
+}
+
+func newTestContext(req *http.Request) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = req
+	return c, rec
+}
+
+func TestRequireScopeAllowsExistingUserID(t *testing.T) {
+	srv, _ := newTestServer(t, ScopeStoriesRead)
+
+	c, rec := newTestContext(httptest.NewRequest(http.MethodGet, "/stories", nil))
+	c.Set("user_id", uint(1))
+
+	requireScope(srv, ScopeStoriesRead)(c)
+
+	if rec.Code != http.StatusOK || c.IsAborted() {
+		t.Errorf("requireScope aborted a request already authenticated via user_id (status=%d)", rec.Code)
+	}
+}
+
+func TestRequireScopeAcceptsBearerTokenWithGrantedScope(t *testing.T) {
+	srv, access := newTestServer(t, ScopeStoriesRead)
+
+	req := httptest.NewRequest(http.MethodGet, "/stories", nil)
+	req.Header.Set("Authorization", "Bearer "+access)
+	c, rec := newTestContext(req)
+
+	requireScope(srv, ScopeStoriesRead)(c)
+
+	if c.IsAborted() {
+		t.Errorf("requireScope rejected a bearer token granted the required scope (status=%d)", rec.Code)
+	}
+}
+
+func TestRequireScopeRejectsInsufficientScope(t *testing.T) {
+	srv, access := newTestServer(t, ScopeStoriesRead)
+
+	req := httptest.NewRequest(http.MethodGet, "/assessments", nil)
+	req.Header.Set("Authorization", "Bearer "+access)
+	c, rec := newTestContext(req)
+
+	requireScope(srv, ScopeAssessmentsWrite)(c)
+
+	if !c.IsAborted() || rec.Code != http.StatusForbidden {
+		t.Errorf("requireScope allowed a token missing the required scope (status=%d, aborted=%v)", rec.Code, c.IsAborted())
+	}
+}
+
+func TestRequireScopeLeavesUserIDUnsetForClientCredentialsToken(t *testing.T) {
+	// A client-credentials token authenticates an app, not a person, so
+	// go-oauth2 never attaches a user id to it. requireScope must let the
+	// correctly-scoped request through without inventing a user_id; callers
+	// that need one (e.g. POST /assessments/start) are responsible for
+	// checking for its absence rather than assuming it's always set.
+	srv, access := newTestServer(t, ScopeStoriesRead)
+
+	req := httptest.NewRequest(http.MethodGet, "/stories", nil)
+	req.Header.Set("Authorization", "Bearer "+access)
+	c, rec := newTestContext(req)
+
+	requireScope(srv, ScopeStoriesRead)(c)
+
+	if c.IsAborted() {
+		t.Fatalf("requireScope rejected a bearer token granted the required scope (status=%d)", rec.Code)
+	}
+	if _, ok := c.Get("user_id"); ok {
+		t.Errorf("requireScope set user_id for a client-credentials token, which carries none")
+	}
+}
+
+func TestRequireScopeSetsOAuthScopeForBearerToken(t *testing.T) {
+	// The GraphQL route multiplexes operations with different required
+	// scopes behind a single RequireAuthenticated check, so it re-derives the
+	// per-operation check from this context value inside the resolvers.
+	srv, access := newTestServer(t, ScopeStoriesRead)
+
+	req := httptest.NewRequest(http.MethodGet, "/stories", nil)
+	req.Header.Set("Authorization", "Bearer "+access)
+	c, _ := newTestContext(req)
+
+	requireScope(srv, ScopeStoriesRead)(c)
+
+	scope, ok := c.Get("oauth_scope")
+	if !ok || scope.(string) != ScopeStoriesRead {
+		t.Errorf("requireScope did not record the bearer token's granted scope, got %v (ok=%v)", scope, ok)
+	}
+}
+
+func TestRequireScopeDoesNotSetOAuthScopeForSessionAuthenticatedCaller(t *testing.T) {
+	srv, _ := newTestServer(t, ScopeStoriesRead)
+
+	c, _ := newTestContext(httptest.NewRequest(http.MethodGet, "/stories", nil))
+	c.Set("user_id", uint(1))
+
+	requireScope(srv, ScopeStoriesRead)(c)
+
+	if _, ok := c.Get("oauth_scope"); ok {
+		t.Error("requireScope set oauth_scope for a caller already authenticated via session/JWT")
+	}
+}
+
+func TestRequireScopeRejectsMissingCredentials(t *testing.T) {
+	srv, _ := newTestServer(t, ScopeStoriesRead)
+
+	c, rec := newTestContext(httptest.NewRequest(http.MethodGet, "/stories", nil))
+
+	requireScope(srv, ScopeStoriesRead)(c)
+
+	if !c.IsAborted() || rec.Code != http.StatusUnauthorized {
+		t.Errorf("requireScope allowed an unauthenticated request through (status=%d, aborted=%v)", rec.Code, c.IsAborted())
+	}
+}
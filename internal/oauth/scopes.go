@@ -0,0 +1,33 @@
+package oauth
+
+import "strings"
+
+// Scopes understood by the Inkwell OAuth2 provider.
+const (
+	ScopeStoriesRead      = "stories:read"
+	ScopeAssessmentsWrite = "assessments:write"
+	ScopeProfile          = "profile"
+)
+
+// HasScope reports whether granted (a space-delimited scope string) contains
+// required. Exported for callers outside this package, such as the GraphQL
+// resolvers, that enforce the same per-operation scopes RequireScope applies
+// to the REST routes.
+func HasScope(granted, required string) bool {
+	for _, s := range strings.Fields(granted) {
+		if s == required {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubset reports whether every scope in requested also appears in allowed.
+func isSubset(requested, allowed string) bool {
+	for _, s := range strings.Fields(requested) {
+		if !HasScope(allowed, s) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package oauth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-oauth2/oauth2/v4"
+	oautherrors "github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/generates"
+	"github.com/go-oauth2/oauth2/v4/manage"
+	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/go-oauth2/oauth2/v4/store"
+	gsessions "github.com/gin-contrib/sessions"
+
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// NewServer builds the Inkwell OAuth2 authorization server: authorization-code
+// (with PKCE), refresh-token, and client-credentials grants, backed by
+// clientRepo for client lookups and the same session store used for the
+// gin-contrib/sessions cookie/Redis backend for identifying the logged-in
+// resource owner during the /oauth/authorize step.
+func NewServer(sessionStore gsessions.Store, sessionName string, clientRepo repository.OAuthClientRepository) *server.Server {
+	manager := manage.NewDefaultManager()
+	manager.SetAuthorizeCodeTokenCfg(manage.DefaultAuthorizeCodeTokenCfg)
+	manager.SetClientTokenCfg(manage.DefaultClientTokenCfg)
+	manager.SetRefreshTokenCfg(manage.DefaultRefreshTokenCfg)
+	manager.MustTokenStorage(store.NewMemoryTokenStore())
+	manager.MapAccessGenerate(generates.NewAccessGenerate())
+	manager.MapClientStorage(NewClientStore(clientRepo))
+
+	cfg := &server.Config{
+		TokenType:             "Bearer",
+		AllowGetAccessRequest: true,
+		AllowedResponseTypes:  []oauth2.ResponseType{oauth2.Code},
+		AllowedGrantTypes: []oauth2.GrantType{
+			oauth2.AuthorizationCode,
+			oauth2.Refreshing,
+			oauth2.ClientCredentials,
+		},
+		AllowedCodeChallengeMethods: []oauth2.CodeChallengeMethod{
+			oauth2.CodeChallengeS256,
+			oauth2.CodeChallengePlain,
+		},
+	}
+
+	srv := server.NewServer(cfg, manager)
+	srv.SetClientInfoHandler(server.ClientFormHandler)
+
+	srv.SetUserAuthorizationHandler(func(w http.ResponseWriter, r *http.Request) (string, error) {
+		sess, err := sessionStore.Get(r, sessionName)
+		if err != nil {
+			return "", err
+		}
+
+		userID, ok := sess.Values["user_id"]
+		if !ok {
+			return "", errors.New("login required before authorizing this client")
+		}
+
+		return fmt.Sprintf("%v", userID), nil
+	})
+
+	srv.SetClientScopeHandler(func(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+		client, err := clientRepo.FindByClientID(tgr.ClientID)
+		if err != nil {
+			return false, oautherrors.ErrInvalidClient
+		}
+
+		if tgr.Scope != "" && !isSubset(tgr.Scope, client.Scopes) {
+			return false, oautherrors.ErrInvalidScope
+		}
+
+		return true, nil
+	})
+
+	return srv
+}
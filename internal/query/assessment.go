@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+
+	"inkwell-backend-V2.0/internal/bus"
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/service"
+)
+
+// FindAssessmentQuery looks up an assessment by its opaque session id.
+type FindAssessmentQuery struct {
+	SessionID string
+}
+
+// RegisterAssessmentHandlers wires the assessment read-side handlers onto b.
+func RegisterAssessmentHandlers(b *bus.Bus, assessmentService *service.AssessmentService) {
+	bus.Register(b, func(ctx context.Context, q FindAssessmentQuery) (*model.Assessment, error) {
+		return assessmentService.GetAssessmentBySessionID(q.SessionID)
+	})
+}
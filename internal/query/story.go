@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"inkwell-backend-V2.0/internal/bus"
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/service"
+)
+
+// ListStoriesQuery lists every published story. It takes no filters today but
+// is a struct (rather than a bare call) so future filters don't change the bus contract.
+type ListStoriesQuery struct{}
+
+// RegisterStoryHandlers wires the story read-side handlers onto b.
+func RegisterStoryHandlers(b *bus.Bus, storyService *service.StoryService) {
+	bus.Register(b, func(ctx context.Context, q ListStoriesQuery) ([]model.Story, error) {
+		return storyService.GetStories()
+	})
+}
@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+
+	"inkwell-backend-V2.0/internal/bus"
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/service"
+)
+
+// FindCurrentUserQuery looks up the authenticated caller's own profile.
+type FindCurrentUserQuery struct {
+	UserID uint
+}
+
+// RegisterUserHandlers wires the user read-side handlers onto b.
+func RegisterUserHandlers(b *bus.Bus, userService *service.UserService) {
+	bus.Register(b, func(ctx context.Context, q FindCurrentUserQuery) (*model.User, error) {
+		return userService.FindByID(q.UserID)
+	})
+}
@@ -0,0 +1,91 @@
+package realtime
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"inkwell-backend-V2.0/internal/events"
+)
+
+const (
+	writeWait  = 10 * time.Second
+	pongWait   = 60 * time.Second
+	pingPeriod = (pongWait * 9) / 10
+)
+
+// client wraps one websocket connection subscribed to a single room.
+type client struct {
+	hub  *Hub
+	room string
+	conn *websocket.Conn
+	send chan events.Event
+}
+
+func newClient(hub *Hub, room string, conn *websocket.Conn) *client {
+	return &client{hub: hub, room: room, conn: conn, send: make(chan events.Event, 16)}
+}
+
+// deliver enqueues evt for this client, dropping it if the client is too far
+// behind rather than blocking the publisher.
+func (c *client) deliver(evt events.Event) {
+	select {
+	case c.send <- evt:
+	default:
+	}
+}
+
+// readPump discards inbound messages (this protocol is server-push only) but
+// keeps the connection's read deadline alive via pong handling.
+func (c *client) readPump() {
+	defer func() {
+		c.hub.leave(c.room, c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// writePump drains c.send to the socket and pings to keep intermediaries from
+// closing the connection.
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case evt, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			payload, err := json.Marshal(evt)
+			if err != nil {
+				continue
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
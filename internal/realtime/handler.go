@@ -0,0 +1,125 @@
+package realtime
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"inkwell-backend-V2.0/internal/events"
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// resolveRoom authorizes the caller for the room implied by the request's
+// query parameters and returns it. Exactly one of "session_id" or "user_id"
+// must be given: "session_id" joins that assessment session's room, which
+// requires the caller to either own the session or be an admin (the same
+// elevated role the OAuth client admin endpoints require) — otherwise any
+// logged-in user could watch another learner's live answers by guessing
+// their session id. "user_id" joins the room a teacher uses to watch one
+// learner's progress across sessions, which requires the caller to be that
+// user or an admin.
+func resolveRoom(c *gin.Context, assessmentRepo repository.AssessmentRepository, userRepo repository.UserRepository) (string, bool) {
+	callerID, authenticated := c.Get("user_id")
+	if !authenticated {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return "", false
+	}
+
+	isAdmin := func() bool {
+		caller, err := userRepo.FindByID(callerID.(uint))
+		return err == nil && caller.IsAdmin
+	}
+
+	if sessionID := c.Query("session_id"); sessionID != "" {
+		assessment, err := assessmentRepo.FindBySessionID(sessionID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return "", false
+		}
+		if assessment.UserID != callerID.(uint) && !isAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to watch this session"})
+			return "", false
+		}
+		return events.SessionRoom(sessionID), true
+	}
+
+	if userIDParam := c.Query("user_id"); userIDParam != "" {
+		watchedID, err := strconv.ParseUint(userIDParam, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user_id"})
+			return "", false
+		}
+		if uint(watchedID) != callerID.(uint) && !isAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to watch this user"})
+			return "", false
+		}
+		return events.UserRoom(uint(watchedID)), true
+	}
+
+	c.JSON(http.StatusBadRequest, gin.H{"error": "session_id or user_id is required"})
+	return "", false
+}
+
+// ServeWS upgrades the request to a websocket subscribed to the room named by
+// the "session_id" or "user_id" query parameter (see resolveRoom). If
+// "last_event_id" is given, every buffered event after that id is replayed
+// before live events start flowing, so a client that reconnects after a drop
+// doesn't miss anything still in the history window.
+func ServeWS(hub *Hub, assessmentRepo repository.AssessmentRepository, userRepo repository.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		room, ok := resolveRoom(c, assessmentRepo, userRepo)
+		if !ok {
+			return
+		}
+
+		conn, err := hub.upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			return
+		}
+
+		cl := newClient(hub, room, conn)
+		hub.join(room, cl)
+
+		if lastEventID, ok := parseLastEventID(c); ok {
+			for _, evt := range hub.EventsSince(room, lastEventID) {
+				cl.deliver(evt)
+			}
+		}
+
+		go cl.writePump()
+		cl.readPump()
+	}
+}
+
+// Poll is the long-poll fallback for clients that can't hold a websocket
+// open: it returns every event recorded for the room named by "session_id" or
+// "user_id" (see resolveRoom) after last_event_id.
+func Poll(hub *Hub, assessmentRepo repository.AssessmentRepository, userRepo repository.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		room, ok := resolveRoom(c, assessmentRepo, userRepo)
+		if !ok {
+			return
+		}
+
+		lastEventID, _ := parseLastEventID(c)
+		evts := hub.EventsSince(room, lastEventID)
+		if evts == nil {
+			evts = []events.Event{}
+		}
+
+		c.JSON(http.StatusOK, gin.H{"events": evts})
+	}
+}
+
+func parseLastEventID(c *gin.Context) (uint64, bool) {
+	raw := c.Query("last_event_id")
+	if raw == "" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,123 @@
+// Package realtime fans out assessment session events to connected clients
+// over a websocket, with a long-poll fallback for clients that can't hold a
+// persistent connection open.
+package realtime
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"inkwell-backend-V2.0/internal/events"
+)
+
+// historyPerRoom bounds the replay buffer kept for each room, used both by
+// the long-poll fallback and to let a reconnecting websocket client resume
+// from the last event id it saw.
+const historyPerRoom = 100
+
+// Hub fans out events.Event messages to every client subscribed to the
+// event's room. It implements events.Publisher, so the service layer can
+// publish without knowing anything about websockets.
+type Hub struct {
+	mu       sync.RWMutex
+	clients  map[string]map[*client]struct{} // room -> clients
+	history  map[string][]events.Event       // room -> recent events
+	nextID   uint64
+	upgrader websocket.Upgrader
+}
+
+// NewHub builds an empty Hub. allowedOrigins restricts the Origin header a
+// browser-initiated /realtime/ws handshake may carry; an empty list falls
+// back to same-origin only. Sessions authenticate the handshake by cookie,
+// so without an origin check any third-party page could open a socket using
+// a logged-in victim's browser (cross-site websocket hijacking).
+func NewHub(allowedOrigins []string) *Hub {
+	return &Hub{
+		clients:  make(map[string]map[*client]struct{}),
+		history:  make(map[string][]events.Event),
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     checkOrigin(allowedOrigins),
+		},
+	}
+}
+
+// checkOrigin builds a websocket.Upgrader.CheckOrigin func that allows only
+// the given Origins, falling back to same-origin (the gorilla/websocket
+// default) when none are configured.
+func checkOrigin(allowedOrigins []string) func(*http.Request) bool {
+	allowed := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[origin] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		if len(allowed) == 0 {
+			u, err := url.Parse(origin)
+			return err == nil && u.Host == r.Host
+		}
+		_, ok := allowed[origin]
+		return ok
+	}
+}
+
+// Publish implements events.Publisher.
+func (h *Hub) Publish(evt events.Event) {
+	h.mu.Lock()
+	h.nextID++
+	evt.ID = h.nextID
+
+	h.history[evt.Room] = append(h.history[evt.Room], evt)
+	if len(h.history[evt.Room]) > historyPerRoom {
+		h.history[evt.Room] = h.history[evt.Room][len(h.history[evt.Room])-historyPerRoom:]
+	}
+
+	recipients := make([]*client, 0, len(h.clients[evt.Room]))
+	for c := range h.clients[evt.Room] {
+		recipients = append(recipients, c)
+	}
+	h.mu.Unlock()
+
+	for _, c := range recipients {
+		c.deliver(evt)
+	}
+}
+
+// EventsSince returns every event recorded for room after lastEventID, oldest
+// first. Used by the long-poll fallback and to replay missed events to a
+// client that reconnects with (session_id, last_event_id).
+func (h *Hub) EventsSince(room string, lastEventID uint64) []events.Event {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var out []events.Event
+	for _, evt := range h.history[room] {
+		if evt.ID > lastEventID {
+			out = append(out, evt)
+		}
+	}
+	return out
+}
+
+func (h *Hub) join(room string, c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.clients[room] == nil {
+		h.clients[room] = make(map[*client]struct{})
+	}
+	h.clients[room][c] = struct{}{}
+}
+
+func (h *Hub) leave(room string, c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients[room], c)
+}
@@ -0,0 +1,127 @@
+package realtime
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"inkwell-backend-V2.0/internal/events"
+)
+
+func TestCheckOriginDefaultsToSameOrigin(t *testing.T) {
+	check := checkOrigin(nil)
+
+	req := httptest.NewRequest("GET", "http://inkwell.example/realtime/ws", nil)
+	req.Header.Set("Origin", "http://inkwell.example")
+	if !check(req) {
+		t.Error("checkOrigin rejected a same-origin request with no allowlist configured")
+	}
+
+	req.Header.Set("Origin", "http://evil.example")
+	if check(req) {
+		t.Error("checkOrigin allowed a cross-origin request with no allowlist configured")
+	}
+}
+
+func TestCheckOriginAllowsConfiguredOrigins(t *testing.T) {
+	check := checkOrigin([]string{"https://app.inkwell.example"})
+
+	req := httptest.NewRequest("GET", "http://inkwell.example/realtime/ws", nil)
+	req.Header.Set("Origin", "https://app.inkwell.example")
+	if !check(req) {
+		t.Error("checkOrigin rejected an explicitly allowed origin")
+	}
+
+	req.Header.Set("Origin", "https://evil.example")
+	if check(req) {
+		t.Error("checkOrigin allowed an origin outside the configured allowlist")
+	}
+}
+
+func TestCheckOriginAllowsRequestsWithNoOriginHeader(t *testing.T) {
+	check := checkOrigin([]string{"https://app.inkwell.example"})
+
+	req := httptest.NewRequest("GET", "http://inkwell.example/realtime/ws", nil)
+	if !check(req) {
+		t.Error("checkOrigin rejected a request with no Origin header (e.g. a non-browser client)")
+	}
+}
+
+func TestHubPublishDeliversToRoomSubscribers(t *testing.T) {
+	hub := NewHub(nil)
+	cl := newClient(hub, "session:abc", nil)
+	hub.join("session:abc", cl)
+
+	hub.Publish(events.Event{Type: events.TypeAnswerGraded, Room: "session:abc", Payload: "graded"})
+
+	select {
+	case evt := <-cl.send:
+		if evt.Type != events.TypeAnswerGraded {
+			t.Errorf("got event type %q, want %q", evt.Type, events.TypeAnswerGraded)
+		}
+	default:
+		t.Fatal("subscriber never received the published event")
+	}
+}
+
+func TestHubPublishDoesNotLeakAcrossRooms(t *testing.T) {
+	hub := NewHub(nil)
+	cl := newClient(hub, "session:abc", nil)
+	hub.join("session:abc", cl)
+
+	hub.Publish(events.Event{Type: events.TypeAnswerGraded, Room: "session:other", Payload: "graded"})
+
+	select {
+	case evt := <-cl.send:
+		t.Fatalf("subscriber to session:abc received an event for a different room: %+v", evt)
+	default:
+	}
+}
+
+func TestHubLeaveStopsDelivery(t *testing.T) {
+	hub := NewHub(nil)
+	cl := newClient(hub, "session:abc", nil)
+	hub.join("session:abc", cl)
+	hub.leave("session:abc", cl)
+
+	hub.Publish(events.Event{Type: events.TypeAnswerGraded, Room: "session:abc", Payload: "graded"})
+
+	select {
+	case evt := <-cl.send:
+		t.Fatalf("client received an event after leaving the room: %+v", evt)
+	default:
+	}
+}
+
+func TestHubEventsSinceReturnsOnlyNewerEvents(t *testing.T) {
+	hub := NewHub(nil)
+
+	hub.Publish(events.Event{Type: events.TypeQuestionNext, Room: "session:abc"})
+	hub.Publish(events.Event{Type: events.TypeAnswerGraded, Room: "session:abc"})
+	hub.Publish(events.Event{Type: events.TypeSessionCompleted, Room: "session:abc"})
+
+	all := hub.EventsSince("session:abc", 0)
+	if len(all) != 3 {
+		t.Fatalf("EventsSince(0) returned %d events, want 3", len(all))
+	}
+
+	since := hub.EventsSince("session:abc", all[0].ID)
+	if len(since) != 2 {
+		t.Fatalf("EventsSince(%d) returned %d events, want 2", all[0].ID, len(since))
+	}
+	if since[0].Type != events.TypeAnswerGraded {
+		t.Errorf("EventsSince first event type = %q, want %q", since[0].Type, events.TypeAnswerGraded)
+	}
+}
+
+func TestHubEventsSinceBoundsHistoryPerRoom(t *testing.T) {
+	hub := NewHub(nil)
+
+	for i := 0; i < historyPerRoom+10; i++ {
+		hub.Publish(events.Event{Type: events.TypeSessionProgress, Room: "session:abc"})
+	}
+
+	all := hub.EventsSince("session:abc", 0)
+	if len(all) != historyPerRoom {
+		t.Errorf("history for a room has %d events, want bounded to %d", len(all), historyPerRoom)
+	}
+}
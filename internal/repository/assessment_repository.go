@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"inkwell-backend-V2.0/internal/db"
+	"inkwell-backend-V2.0/internal/model"
+)
+
+// AssessmentRepository provides persistence operations for model.Assessment.
+type AssessmentRepository interface {
+	Create(assessment *model.Assessment) error
+	FindBySessionID(sessionID string) (*model.Assessment, error)
+	SaveAnswer(answer *model.Answer) error
+}
+
+type assessmentRepository struct{}
+
+// NewAssessmentRepository builds an AssessmentRepository backed by the process-wide database handle.
+func NewAssessmentRepository() AssessmentRepository {
+	return &assessmentRepository{}
+}
+
+func (r *assessmentRepository) Create(assessment *model.Assessment) error {
+	return db.GetDB().Create(assessment).Error
+}
+
+func (r *assessmentRepository) FindBySessionID(sessionID string) (*model.Assessment, error) {
+	var assessment model.Assessment
+	if err := db.GetDB().Preload("Questions").Preload("Answers").
+		Where("session_id = ?", sessionID).First(&assessment).Error; err != nil {
+		return nil, err
+	}
+	return &assessment, nil
+}
+
+func (r *assessmentRepository) SaveAnswer(answer *model.Answer) error {
+	return db.GetDB().Create(answer).Error
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"inkwell-backend-V2.0/internal/db"
+	"inkwell-backend-V2.0/internal/model"
+)
+
+// OAuthClientRepository provides persistence operations for model.OAuthClient.
+type OAuthClientRepository interface {
+	Create(client *model.OAuthClient) error
+	FindByClientID(clientID string) (*model.OAuthClient, error)
+	FindAll() ([]model.OAuthClient, error)
+	UpdateSecretHash(clientID, secretHash string) error
+}
+
+type oauthClientRepository struct{}
+
+// NewOAuthClientRepository builds an OAuthClientRepository backed by the process-wide database handle.
+func NewOAuthClientRepository() OAuthClientRepository {
+	return &oauthClientRepository{}
+}
+
+func (r *oauthClientRepository) Create(client *model.OAuthClient) error {
+	return db.GetDB().Create(client).Error
+}
+
+func (r *oauthClientRepository) FindByClientID(clientID string) (*model.OAuthClient, error) {
+	var client model.OAuthClient
+	if err := db.GetDB().Where("client_id = ?", clientID).First(&client).Error; err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+func (r *oauthClientRepository) FindAll() ([]model.OAuthClient, error) {
+	var clients []model.OAuthClient
+	if err := db.GetDB().Find(&clients).Error; err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (r *oauthClientRepository) UpdateSecretHash(clientID, secretHash string) error {
+	return db.GetDB().Model(&model.OAuthClient{}).Where("client_id = ?", clientID).Update("secret_hash", secretHash).Error
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"inkwell-backend-V2.0/internal/db"
+	"inkwell-backend-V2.0/internal/model"
+)
+
+// RefreshTokenRepository provides persistence operations for model.RefreshToken.
+type RefreshTokenRepository interface {
+	Create(token *model.RefreshToken) error
+	FindByHash(tokenHash string) (*model.RefreshToken, error)
+	Revoke(id uint) error
+	RevokeAllForUser(userID uint) error
+}
+
+type refreshTokenRepository struct{}
+
+// NewRefreshTokenRepository builds a RefreshTokenRepository backed by the process-wide database handle.
+func NewRefreshTokenRepository() RefreshTokenRepository {
+	return &refreshTokenRepository{}
+}
+
+func (r *refreshTokenRepository) Create(token *model.RefreshToken) error {
+	return db.GetDB().Create(token).Error
+}
+
+func (r *refreshTokenRepository) FindByHash(tokenHash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	if err := db.GetDB().Where("token_hash = ? AND revoked = ? AND expires_at > ?", tokenHash, false, time.Now()).
+		First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) Revoke(id uint) error {
+	return db.GetDB().Model(&model.RefreshToken{}).Where("id = ?", id).Update("revoked", true).Error
+}
+
+func (r *refreshTokenRepository) RevokeAllForUser(userID uint) error {
+	return db.GetDB().Model(&model.RefreshToken{}).Where("user_id = ?", userID).Update("revoked", true).Error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"inkwell-backend-V2.0/internal/db"
+	"inkwell-backend-V2.0/internal/model"
+)
+
+// StoryRepository provides persistence operations for model.Story.
+type StoryRepository interface {
+	FindAll() ([]model.Story, error)
+}
+
+type storyRepository struct{}
+
+// NewStoryRepository builds a StoryRepository backed by the process-wide database handle.
+func NewStoryRepository() StoryRepository {
+	return &storyRepository{}
+}
+
+func (r *storyRepository) FindAll() ([]model.Story, error) {
+	var stories []model.Story
+	if err := db.GetDB().Find(&stories).Error; err != nil {
+		return nil, err
+	}
+	return stories, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"inkwell-backend-V2.0/internal/db"
+	"inkwell-backend-V2.0/internal/model"
+)
+
+// UserRepository provides persistence operations for model.User.
+type UserRepository interface {
+	Create(user *model.User) error
+	FindByEmail(email string) (*model.User, error)
+	FindByID(id uint) (*model.User, error)
+	FindAll() ([]model.User, error)
+}
+
+type userRepository struct{}
+
+// NewUserRepository builds a UserRepository backed by the process-wide database handle.
+func NewUserRepository() UserRepository {
+	return &userRepository{}
+}
+
+func (r *userRepository) Create(user *model.User) error {
+	return db.GetDB().Create(user).Error
+}
+
+func (r *userRepository) FindByEmail(email string) (*model.User, error) {
+	var user model.User
+	if err := db.GetDB().Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindByID(id uint) (*model.User, error) {
+	var user model.User
+	if err := db.GetDB().First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindAll() ([]model.User, error) {
+	var users []model.User
+	if err := db.GetDB().Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return users, nil
+}
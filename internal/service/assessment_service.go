@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/google/uuid"
+
+	"inkwell-backend-V2.0/internal/events"
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// AssessmentService drives creation and grading of assessment sessions.
+type AssessmentService struct {
+	assessmentRepo repository.AssessmentRepository
+	events         events.Publisher
+}
+
+// NewAssessmentService builds an AssessmentService backed by the given
+// AssessmentRepository. publisher may be nil, in which case SaveAnswer simply
+// skips publishing (useful for tests that don't care about realtime fan-out).
+func NewAssessmentService(assessmentRepo repository.AssessmentRepository, publisher events.Publisher) *AssessmentService {
+	return &AssessmentService{assessmentRepo: assessmentRepo, events: publisher}
+}
+
+// CreateAssessment starts a new assessment session for userID with the given questions.
+func (s *AssessmentService) CreateAssessment(userID uint, title, description string, questions []model.Question) (*model.Assessment, error) {
+	assessment := &model.Assessment{
+		SessionID:   uuid.NewString(),
+		UserID:      userID,
+		Title:       title,
+		Description: description,
+		Questions:   questions,
+	}
+
+	if err := s.assessmentRepo.Create(assessment); err != nil {
+		return nil, err
+	}
+
+	return assessment, nil
+}
+
+// GetAssessmentBySessionID looks up an assessment by its opaque session id.
+func (s *AssessmentService) GetAssessmentBySessionID(sessionID string) (*model.Assessment, error) {
+	return s.assessmentRepo.FindBySessionID(sessionID)
+}
+
+// SaveAnswer persists a graded answer against assessment and fires
+// "answer:graded", "question:next" (when another question remains), plus a
+// "session:progress"/"session:completed" event through the realtime event
+// bus, so any client watching session:<id> or user:<id> hears about it
+// immediately.
+func (s *AssessmentService) SaveAnswer(assessment *model.Assessment, answer *model.Answer) error {
+	if err := s.assessmentRepo.SaveAnswer(answer); err != nil {
+		return err
+	}
+
+	if s.events == nil {
+		return nil
+	}
+
+	refreshed, err := s.assessmentRepo.FindBySessionID(assessment.SessionID)
+	if err != nil {
+		// The answer is already saved; a missed progress event isn't worth failing the request over.
+		return nil
+	}
+
+	progress := events.SessionProgress{
+		SessionID: refreshed.SessionID,
+		Answered:  len(refreshed.Answers),
+		Total:     len(refreshed.Questions),
+		Completed: len(refreshed.Answers) >= len(refreshed.Questions),
+	}
+
+	s.events.Publish(events.Event{
+		Type:    events.TypeAnswerGraded,
+		Room:    events.SessionRoom(refreshed.SessionID),
+		Payload: answer,
+	})
+
+	progressEvent := events.TypeSessionProgress
+	if progress.Completed {
+		progressEvent = events.TypeSessionCompleted
+	} else if next, ok := nextUnansweredQuestion(refreshed); ok {
+		s.events.Publish(events.Event{
+			Type:    events.TypeQuestionNext,
+			Room:    events.SessionRoom(refreshed.SessionID),
+			Payload: next,
+		})
+	}
+	s.events.Publish(events.Event{Type: progressEvent, Room: events.SessionRoom(refreshed.SessionID), Payload: progress})
+	s.events.Publish(events.Event{Type: progressEvent, Room: events.UserRoom(refreshed.UserID), Payload: progress})
+
+	return nil
+}
+
+// nextUnansweredQuestion returns the first of assessment's questions that
+// doesn't yet have a matching answer, so the realtime transport can push it
+// to the learner instead of making them poll for it.
+func nextUnansweredQuestion(assessment *model.Assessment) (model.Question, bool) {
+	answered := make(map[uint]struct{}, len(assessment.Answers))
+	for _, a := range assessment.Answers {
+		answered[a.QuestionID] = struct{}{}
+	}
+
+	for _, q := range assessment.Questions {
+		if _, ok := answered[q.ID]; !ok {
+			return q, true
+		}
+	}
+	return model.Question{}, false
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// TokenPair is the access/refresh credential pair returned on login and refresh.
+type TokenPair struct {
+	Access  string    `json:"access"`
+	Refresh string    `json:"refresh"`
+	Expires time.Time `json:"expires"`
+}
+
+// AuthService handles registration, credential verification, and issuance of
+// session/token based authentication.
+type AuthService struct {
+	userRepo         repository.UserRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	jwtSecret        []byte
+	accessTokenTTL   time.Duration
+	refreshTokenTTL  time.Duration
+}
+
+// NewAuthService builds an AuthService backed by the given repositories. jwtSecret
+// signs access tokens with HS256; accessTokenTTL and refreshTokenTTL bound the
+// lifetime of issued tokens.
+func NewAuthService(userRepo repository.UserRepository, refreshTokenRepo repository.RefreshTokenRepository, jwtSecret string, accessTokenTTL, refreshTokenTTL time.Duration) *AuthService {
+	return &AuthService{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		jwtSecret:        []byte(jwtSecret),
+		accessTokenTTL:   accessTokenTTL,
+		refreshTokenTTL:  refreshTokenTTL,
+	}
+}
+
+// Register creates a new user, hashing the caller-supplied AuthHash at rest.
+func (s *AuthService) Register(user *model.User) error {
+	if _, err := s.userRepo.FindByEmail(user.Email); err == nil {
+		return errors.New("a user with this email already exists")
+	}
+
+	user.AuthHash = hash(user.AuthHash)
+	return s.userRepo.Create(user)
+}
+
+// Login verifies the supplied credentials and returns the matching user.
+func (s *AuthService) Login(email, authHash string) (*model.User, error) {
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil {
+		return nil, errors.New("invalid email or password")
+	}
+
+	if user.AuthHash != hash(authHash) {
+		return nil, errors.New("invalid email or password")
+	}
+
+	return user, nil
+}
+
+// IssueTokens mints a new short-lived JWT access token and opaque refresh token for user.
+func (s *AuthService) IssueTokens(user *model.User) (*TokenPair, error) {
+	expires := time.Now().Add(s.accessTokenTTL)
+
+	access, err := s.signAccessToken(user.ID, expires)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, refreshHash := newOpaqueToken()
+	if err := s.refreshTokenRepo.Create(&model.RefreshToken{
+		UserID:    user.ID,
+		TokenHash: refreshHash,
+		ExpiresAt: time.Now().Add(s.refreshTokenTTL),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{Access: access, Refresh: refresh, Expires: expires}, nil
+}
+
+// ValidateAccess parses and verifies an access token, returning the embedded user id.
+func (s *AuthService) ValidateAccess(accessToken string) (uint, error) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return s.jwtSecret, nil
+	})
+	if err != nil || !token.Valid {
+		return 0, errors.New("invalid or expired access token")
+	}
+
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, errors.New("invalid access token claims")
+	}
+
+	return uint(userID), nil
+}
+
+// Refresh exchanges a valid refresh token for a new token pair, rotating the refresh token.
+func (s *AuthService) Refresh(refreshToken string) (*TokenPair, error) {
+	refreshHash := hash(refreshToken)
+
+	stored, err := s.refreshTokenRepo.FindByHash(refreshHash)
+	if err != nil {
+		return nil, errors.New("invalid or expired refresh token")
+	}
+
+	user, err := s.userRepo.FindByID(stored.UserID)
+	if err != nil {
+		return nil, errors.New("user no longer exists")
+	}
+
+	if err := s.refreshTokenRepo.Revoke(stored.ID); err != nil {
+		return nil, err
+	}
+
+	return s.IssueTokens(user)
+}
+
+// Logout revokes every outstanding refresh token for userID.
+func (s *AuthService) Logout(userID uint) error {
+	return s.refreshTokenRepo.RevokeAllForUser(userID)
+}
+
+func (s *AuthService) signAccessToken(userID uint, expires time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"exp":     expires.Unix(),
+		"iat":     time.Now().Unix(),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.jwtSecret)
+}
+
+func newOpaqueToken() (raw string, hashed string) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	raw = hex.EncodeToString(buf)
+	return raw, hash(raw)
+}
+
+func hash(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"inkwell-backend-V2.0/internal/model"
+)
+
+type fakeUserRepo struct {
+	byEmail map[string]*model.User
+	byID    map[uint]*model.User
+	nextID  uint
+}
+
+func newFakeUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{byEmail: make(map[string]*model.User), byID: make(map[uint]*model.User)}
+}
+
+func (r *fakeUserRepo) Create(user *model.User) error {
+	r.nextID++
+	user.ID = r.nextID
+	r.byEmail[user.Email] = user
+	r.byID[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepo) FindByEmail(email string) (*model.User, error) {
+	user, ok := r.byEmail[email]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepo) FindByID(id uint) (*model.User, error) {
+	user, ok := r.byID[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepo) FindAll() ([]model.User, error) {
+	var out []model.User
+	for _, u := range r.byID {
+		out = append(out, *u)
+	}
+	return out, nil
+}
+
+type fakeRefreshTokenRepo struct {
+	byHash map[string]*model.RefreshToken
+	nextID uint
+}
+
+func newFakeRefreshTokenRepo() *fakeRefreshTokenRepo {
+	return &fakeRefreshTokenRepo{byHash: make(map[string]*model.RefreshToken)}
+}
+
+func (r *fakeRefreshTokenRepo) Create(token *model.RefreshToken) error {
+	r.nextID++
+	token.ID = r.nextID
+	r.byHash[token.TokenHash] = token
+	return nil
+}
+
+func (r *fakeRefreshTokenRepo) FindByHash(tokenHash string) (*model.RefreshToken, error) {
+	token, ok := r.byHash[tokenHash]
+	if !ok || token.Revoked || token.ExpiresAt.Before(time.Now()) {
+		return nil, errors.New("not found")
+	}
+	return token, nil
+}
+
+func (r *fakeRefreshTokenRepo) Revoke(id uint) error {
+	for _, t := range r.byHash {
+		if t.ID == id {
+			t.Revoked = true
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepo) RevokeAllForUser(userID uint) error {
+	for _, t := range r.byHash {
+		if t.UserID == userID {
+			t.Revoked = true
+		}
+	}
+	return nil
+}
+
+func newTestAuthService() (*AuthService, *fakeUserRepo) {
+	userRepo := newFakeUserRepo()
+	refreshRepo := newFakeRefreshTokenRepo()
+	return NewAuthService(userRepo, refreshRepo, "test-secret", time.Minute, time.Hour), userRepo
+}
+
+func TestIssueTokensThenValidateAccess(t *testing.T) {
+	authService, userRepo := newTestAuthService()
+	user := &model.User{Email: "a@example.com"}
+	if err := userRepo.Create(user); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	tokens, err := authService.IssueTokens(user)
+	if err != nil {
+		t.Fatalf("IssueTokens: %v", err)
+	}
+
+	gotID, err := authService.ValidateAccess(tokens.Access)
+	if err != nil {
+		t.Fatalf("ValidateAccess: %v", err)
+	}
+	if gotID != user.ID {
+		t.Errorf("ValidateAccess user id = %d, want %d", gotID, user.ID)
+	}
+}
+
+func TestValidateAccessRejectsGarbage(t *testing.T) {
+	authService, _ := newTestAuthService()
+
+	if _, err := authService.ValidateAccess("not-a-jwt"); err == nil {
+		t.Error("ValidateAccess accepted a malformed token")
+	}
+}
+
+func TestRefreshRotatesToken(t *testing.T) {
+	authService, userRepo := newTestAuthService()
+	user := &model.User{Email: "a@example.com"}
+	if err := userRepo.Create(user); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	tokens, err := authService.IssueTokens(user)
+	if err != nil {
+		t.Fatalf("IssueTokens: %v", err)
+	}
+
+	rotated, err := authService.Refresh(tokens.Refresh)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if rotated.Refresh == tokens.Refresh {
+		t.Error("Refresh returned the same refresh token instead of rotating it")
+	}
+
+	if _, err := authService.Refresh(tokens.Refresh); err == nil {
+		t.Error("Refresh accepted an already-used refresh token")
+	}
+}
+
+func TestLogoutRevokesOutstandingRefreshTokens(t *testing.T) {
+	authService, userRepo := newTestAuthService()
+	user := &model.User{Email: "a@example.com"}
+	if err := userRepo.Create(user); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	tokens, err := authService.IssueTokens(user)
+	if err != nil {
+		t.Fatalf("IssueTokens: %v", err)
+	}
+
+	if err := authService.Logout(user.ID); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+
+	if _, err := authService.Refresh(tokens.Refresh); err == nil {
+		t.Error("Refresh accepted a refresh token revoked by Logout")
+	}
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// StoryService exposes read operations over StoryScape content.
+type StoryService struct {
+	storyRepo repository.StoryRepository
+}
+
+// NewStoryService builds a StoryService backed by the given StoryRepository.
+func NewStoryService(storyRepo repository.StoryRepository) *StoryService {
+	return &StoryService{storyRepo: storyRepo}
+}
+
+// GetStories returns every published story.
+func (s *StoryService) GetStories() ([]model.Story, error) {
+	return s.storyRepo.FindAll()
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"inkwell-backend-V2.0/internal/model"
+	"inkwell-backend-V2.0/internal/repository"
+)
+
+// UserService exposes read operations over registered users.
+type UserService struct {
+	userRepo repository.UserRepository
+}
+
+// NewUserService builds a UserService backed by the given UserRepository.
+func NewUserService(userRepo repository.UserRepository) *UserService {
+	return &UserService{userRepo: userRepo}
+}
+
+// GetAllUsers returns every registered user.
+func (s *UserService) GetAllUsers() ([]model.User, error) {
+	return s.userRepo.FindAll()
+}
+
+// FindByID looks up a single user by primary key.
+func (s *UserService) FindByID(id uint) (*model.User, error) {
+	return s.userRepo.FindByID(id)
+}
@@ -0,0 +1,29 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-contrib/static"
+)
+
+// fileSystem adapts an http.FileSystem to gin-contrib/static's
+// ServeFileSystem interface, which additionally needs to know whether a path
+// exists so the middleware can fall through to NoRoute instead of 404ing.
+type fileSystem struct {
+	http.FileSystem
+}
+
+// NewServeFileSystem wraps fs for use with static.Serve.
+func NewServeFileSystem(fs http.FileSystem) static.ServeFileSystem {
+	return fileSystem{fs}
+}
+
+// Exists implements static.ServeFileSystem.
+func (f fileSystem) Exists(_ string, path string) bool {
+	file, err := f.Open(path)
+	if err != nil {
+		return false
+	}
+	_ = file.Close()
+	return true
+}
@@ -0,0 +1,29 @@
+// Package web embeds the built StoryScape SPA so the Gin binary can serve the
+// frontend on its own, with no separate web server required.
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed all:dist
+var embedded embed.FS
+
+// FS returns the embedded SPA build as an http.FileSystem, rooted at "dist"
+// so paths resolve the same way a live directory passed via --static-dir would.
+func FS() (http.FileSystem, error) {
+	sub, err := fs.Sub(embedded, "dist")
+	if err != nil {
+		return nil, err
+	}
+	return http.FS(sub), nil
+}
+
+// DirFS exposes a live directory as the same http.FileSystem shape as FS, so
+// developers can override the embedded assets with --static-dir during
+// frontend development without rebuilding the binary.
+func DirFS(dir string) http.FileSystem {
+	return http.Dir(dir)
+}